@@ -0,0 +1,103 @@
+// Copyright 2014 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file
+
+package root
+
+import "math"
+
+// unexported brent assumes error checking has all been done, and that the values
+// are correct at the bounds. nFunEvals is how many have already been done, and
+// maxFunEvals is how many can be done.
+//
+// brent implements the van Wijngaarden-Dekker-Brent method, combining inverse
+// quadratic interpolation (or the secant method, when fewer than three distinct
+// function values are available) with bisection so that it converges at least
+// as fast as bisection while usually doing much better on smooth functions.
+func brent(f func(float64) float64, minBound, maxBound bound, tol float64, nFunEvals, maxFunEvals int) (float64, error) {
+	a := minBound.loc
+	fa := minBound.value
+	b := maxBound.loc
+	fb := maxBound.value
+
+	// Ensure |f(b)| <= |f(a)|, i.e. b is the best estimate so far.
+	if math.Abs(fa) < math.Abs(fb) {
+		a, b = b, a
+		fa, fb = fb, fa
+	}
+	c := a
+	fc := fa
+	mflag := true
+	var d float64 // value of b two iterations ago
+
+	for i := 0; i < noRootIter; i++ {
+		if math.Abs(fb) < tol || math.Abs(b-a) < tol {
+			return b, nil
+		}
+		if maxFunEvals > 0 && nFunEvals > maxFunEvals {
+			return b, ErrMaxEval
+		}
+
+		var s float64
+		if fa != fc && fb != fc {
+			// Inverse quadratic interpolation.
+			s = a*fb*fc/((fa-fb)*(fa-fc)) +
+				b*fa*fc/((fb-fa)*(fb-fc)) +
+				c*fa*fb/((fc-fa)*(fc-fb))
+		} else {
+			// Secant method.
+			s = b - fb*(b-a)/(fb-fa)
+		}
+
+		// Decide whether to accept the interpolation step or fall back to
+		// bisection.
+		lo, hi := (3*a+b)/4, b
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		useBisection := s < lo || s > hi
+		if !useBisection {
+			if mflag {
+				useBisection = math.Abs(s-b) >= math.Abs(b-c)/2
+			} else {
+				useBisection = math.Abs(s-b) >= math.Abs(c-d)/2
+			}
+		}
+		if !useBisection {
+			if mflag {
+				useBisection = math.Abs(b-c) < tol
+			} else {
+				useBisection = math.Abs(c-d) < tol
+			}
+		}
+
+		if useBisection {
+			s = (a + b) / 2
+			mflag = true
+		} else {
+			mflag = false
+		}
+
+		fs := f(s)
+		nFunEvals++
+		if math.IsNaN(fs) {
+			return b, ErrNaN
+		}
+
+		d = c
+		c, fc = b, fb
+
+		if sameSign(fa, fs) {
+			a, fa = s, fs
+		} else {
+			b, fb = s, fs
+		}
+
+		// Keep b as the best estimate, a as the contrapoint.
+		if math.Abs(fa) < math.Abs(fb) {
+			a, b = b, a
+			fa, fb = fb, fa
+		}
+	}
+	return b, ErrMaxEval
+}
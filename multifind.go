@@ -0,0 +1,177 @@
+// Copyright 2014 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file
+
+package root
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrSingularJacobian is returned by MultiFind when, at some iterate, the
+// Newton step cannot be solved for either directly or via a QR fallback.
+var ErrSingularJacobian = errors.New("root: singular Jacobian")
+
+const defaultCurvatureFactor = 1e-4
+
+// MultiSettings represents ways to customize MultiFind. Tol is the
+// convergence tolerance on ||F(x)||; if Tol <= 0, 1e-12 is used.
+// MaxIterations caps the number of Newton iterations; if MaxIterations <= 0,
+// noRootIter is used. MaxEvals puts a cap on the number of evaluations of f,
+// counting one evaluation per call plus one per column when a Jacobian is
+// estimated by finite differences; if MaxEvals <= 0, no such cap is placed.
+// CurvatureFactor is the Armijo sufficient-decrease constant used by the
+// backtracking line search and must lie in (0,1); if CurvatureFactor <= 0,
+// a default of 1e-4 is used.
+type MultiSettings struct {
+	Tol             float64
+	MaxIterations   int
+	MaxEvals        int
+	CurvatureFactor float64
+}
+
+// MultiFind finds an x for which F(x) = 0, where F is a system of equations
+// represented by f. f computes F(x) and stores the result in fx in place.
+// jac computes the Jacobian of F at x and stores it in j in place; if jac is
+// nil, the Jacobian is estimated by forward finite differences.
+//
+// MultiFind implements Newton's method: at each iteration it solves
+// J(x_k)*delta = -F(x_k) for the step delta (via mat's LU-based Solve,
+// falling back to a QR solve if the Jacobian is singular), then applies a
+// backtracking line search on ||F||^2 using the Armijo sufficient-decrease
+// test, halving the step on failure, mirroring the Bisection linesearcher
+// in gonum/optimize.
+//
+// MultiFind returns ErrNaN if F evaluates to NaN, ErrMaxEval if the
+// evaluation cap is reached, ErrSingularJacobian if the Jacobian cannot be
+// solved by either method, and ErrNoRoot if the line search cannot find an
+// accepted step.
+func MultiFind(f func(x, fx []float64), jac func(x []float64, j *mat.Dense), x0 []float64, settings *MultiSettings) ([]float64, error) {
+	n := len(x0)
+
+	tol := 1e-12
+	maxIter := noRootIter
+	var maxEvals int
+	curvatureFactor := defaultCurvatureFactor
+	if settings != nil {
+		if settings.Tol > 0 {
+			tol = settings.Tol
+		}
+		if settings.MaxIterations > 0 {
+			maxIter = settings.MaxIterations
+		}
+		maxEvals = settings.MaxEvals
+		if settings.CurvatureFactor > 0 {
+			curvatureFactor = settings.CurvatureFactor
+		}
+	}
+
+	x := make([]float64, n)
+	copy(x, x0)
+	fx := make([]float64, n)
+	f(x, fx)
+	nFunEvals := 1
+
+	jacobian := mat.NewDense(n, n, nil)
+	negF := mat.NewVecDense(n, nil)
+	var delta mat.VecDense
+
+	for iter := 0; iter < maxIter; iter++ {
+		norm2 := dotSelf(fx)
+		if math.Sqrt(norm2) < tol {
+			return x, nil
+		}
+		for _, v := range fx {
+			if math.IsNaN(v) {
+				return x, ErrNaN
+			}
+		}
+
+		if jac != nil {
+			jac(x, jacobian)
+		} else {
+			finiteDifferenceJacobian(f, x, fx, jacobian)
+			nFunEvals += n
+			if maxEvals > 0 && nFunEvals > maxEvals {
+				return x, ErrMaxEval
+			}
+		}
+		for i := 0; i < n; i++ {
+			negF.SetVec(i, -fx[i])
+		}
+
+		if err := delta.SolveVec(jacobian, negF); err != nil {
+			var qr mat.QR
+			qr.Factorize(jacobian)
+			if err := qr.SolveVecTo(&delta, false, negF); err != nil {
+				return x, ErrSingularJacobian
+			}
+		}
+
+		// Backtracking line search on ||F||^2 with the Armijo test.
+		step := 1.0
+		xNew := make([]float64, n)
+		fxNew := make([]float64, n)
+		accepted := false
+		for ls := 0; ls < noRootIter; ls++ {
+			for i := range x {
+				xNew[i] = x[i] + step*delta.AtVec(i)
+			}
+			f(xNew, fxNew)
+			nFunEvals++
+			if maxEvals > 0 && nFunEvals > maxEvals {
+				return x, ErrMaxEval
+			}
+			for _, v := range fxNew {
+				if math.IsNaN(v) {
+					return x, ErrNaN
+				}
+			}
+			if dotSelf(fxNew) <= (1-2*step*curvatureFactor)*norm2 {
+				accepted = true
+				break
+			}
+			step /= 2
+		}
+		if !accepted {
+			return x, ErrNoRoot
+		}
+
+		copy(x, xNew)
+		copy(fx, fxNew)
+	}
+	return x, ErrMaxEval
+}
+
+// dotSelf returns the squared Euclidean norm of v.
+func dotSelf(v []float64) float64 {
+	var s float64
+	for _, e := range v {
+		s += e * e
+	}
+	return s
+}
+
+// finiteDifferenceJacobian estimates the Jacobian of f at x by forward
+// differences, given the already-computed value fx = F(x), and stores the
+// result in jac in place.
+func finiteDifferenceJacobian(f func(x, fx []float64), x, fx []float64, jac *mat.Dense) {
+	n := len(x)
+	xPert := make([]float64, n)
+	copy(xPert, x)
+	fPert := make([]float64, n)
+	for j := 0; j < n; j++ {
+		h := math.Sqrt(machineEps) * math.Max(1, math.Abs(x[j]))
+		xPert[j] = x[j] + h
+		f(xPert, fPert)
+		xPert[j] = x[j]
+		for i := 0; i < n; i++ {
+			jac.Set(i, j, (fPert[i]-fx[i])/h)
+		}
+	}
+}
+
+const machineEps = 2.220446049250313e-16
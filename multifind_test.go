@@ -0,0 +1,63 @@
+// Copyright 2014 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file
+
+package root
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestMultiFind(t *testing.T) {
+	for _, test := range []struct {
+		Name string
+		Fun  func(x, fx []float64)
+		Jac  func(x []float64, j *mat.Dense)
+		X0   []float64
+		Tol  float64
+		Ans  []float64
+	}{
+		{
+			// F(x,y) = [x^2 + y^2 - 4, x - y]
+			Name: "CircleIntersectLine",
+			Fun: func(x, fx []float64) {
+				fx[0] = x[0]*x[0] + x[1]*x[1] - 4
+				fx[1] = x[0] - x[1]
+			},
+			Jac: func(x []float64, j *mat.Dense) {
+				j.Set(0, 0, 2*x[0])
+				j.Set(0, 1, 2*x[1])
+				j.Set(1, 0, 1)
+				j.Set(1, 1, -1)
+			},
+			X0:  []float64{1, 0.5},
+			Tol: 1e-10,
+			Ans: []float64{math.Sqrt2, math.Sqrt2},
+		},
+		{
+			// Same system, but with a finite-difference Jacobian.
+			Name: "CircleIntersectLineFD",
+			Fun: func(x, fx []float64) {
+				fx[0] = x[0]*x[0] + x[1]*x[1] - 4
+				fx[1] = x[0] - x[1]
+			},
+			X0:  []float64{1, 0.5},
+			Tol: 1e-8,
+			Ans: []float64{math.Sqrt2, math.Sqrt2},
+		},
+	} {
+		ans, err := MultiFind(test.Fun, test.Jac, test.X0, &MultiSettings{Tol: test.Tol})
+		if err != nil {
+			t.Errorf("Case %v: error in MultiFind: %v", test.Name, err)
+			continue
+		}
+		for i := range ans {
+			if math.Abs(ans[i]-test.Ans[i]) > 1e-6 {
+				t.Errorf("Case %v: tolerance not met at index %d. Want %v, Got %v", test.Name, i, test.Ans[i], ans[i])
+			}
+		}
+	}
+}
@@ -4,6 +4,11 @@ import (
 	"github.com/gonum/general"
 )
 
+const (
+	defaultNewtonMaxIter = 100
+	defaultNewtonTol     = 1e-12
+)
+
 type Newton struct {
 	Max_Iter int
 	Tol      float64
@@ -12,8 +17,9 @@ type Newton struct {
 
 func NewNewton(f general.FuncDiffer) *Newton {
 	n := new(Newton)
-	n.Max_Iter = max_Iter
-	n.Tol = tol
+	n.Max_Iter = defaultNewtonMaxIter
+	n.Tol = defaultNewtonTol
+	n.fn = f
 	return n
 }
 
@@ -27,7 +33,7 @@ func (n *Newton) Compute(x0 float64) (x float64) {
 		if general.Tolerance(p, p0, n.Tol) {
 			return p
 		}
+		p0 = p
 	}
-	p0 = p
 	return p
 }
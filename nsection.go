@@ -0,0 +1,106 @@
+// Copyright 2014 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file
+
+package root
+
+import (
+	"math"
+	"sync"
+)
+
+// unexported parallelNsection assumes error checking has all been done, and
+// that the values are correct at the bounds. nFunEvals is how many have
+// already been done, and maxFunEvals is how many can be done.
+//
+// parallelNsection generalizes bisection to splitting the current bracket
+// into workers+1 equal pieces per iteration, evaluating f at the workers
+// interior points concurrently through a worker pool bounded to workers
+// goroutines at a time, and then recursing into whichever of the resulting
+// sub-intervals has endpoints of opposite sign. This shrinks the bracket by
+// a factor of workers+1 per round of parallel evaluations, instead of 2 for
+// plain bisection, at the cost of workers function evaluations per round.
+func parallelNsection(f func(float64) float64, minBound, maxBound bound, tol float64, workers, nFunEvals, maxFunEvals int) (float64, error) {
+	for i := 0; i < noRootIter; i++ {
+		if math.Abs(minBound.value) < tol {
+			return minBound.loc, nil
+		}
+		if math.Abs(maxBound.value) < tol {
+			return maxBound.loc, nil
+		}
+		if math.Abs(maxBound.loc-minBound.loc) < tol {
+			if math.Abs(minBound.value) < math.Abs(maxBound.value) {
+				return minBound.loc, nil
+			}
+			return maxBound.loc, nil
+		}
+		if maxFunEvals > 0 && nFunEvals > maxFunEvals {
+			return minBoundValue(minBound, maxBound), ErrMaxEval
+		}
+
+		locs := make([]float64, workers)
+		vals := make([]float64, workers)
+		width := (maxBound.loc - minBound.loc) / float64(workers+1)
+		for k := range locs {
+			locs[k] = minBound.loc + float64(k+1)*width
+		}
+
+		// Evaluate the interior points concurrently through a pool of at
+		// most workers goroutines.
+		jobs := make(chan int, workers)
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for k := range jobs {
+					vals[k] = f(locs[k])
+				}
+			}()
+		}
+		for k := range locs {
+			jobs <- k
+		}
+		close(jobs)
+		wg.Wait()
+		nFunEvals += workers
+
+		for _, v := range vals {
+			if math.IsNaN(v) {
+				return minBoundValue(minBound, maxBound), ErrNaN
+			}
+		}
+
+		// Scan the endpoints together with the interior points, and recurse
+		// into the (equal-width) sub-interval whose endpoints have opposite
+		// sign.
+		subLocs := make([]float64, 0, workers+2)
+		subVals := make([]float64, 0, workers+2)
+		subLocs = append(subLocs, minBound.loc)
+		subVals = append(subVals, minBound.value)
+		subLocs = append(subLocs, locs...)
+		subVals = append(subVals, vals...)
+		subLocs = append(subLocs, maxBound.loc)
+		subVals = append(subVals, maxBound.value)
+
+		found := false
+		for k := 0; k < len(subLocs)-1; k++ {
+			if math.Abs(subVals[k]) < tol {
+				return subLocs[k], nil
+			}
+			if !sameSign(subVals[k], subVals[k+1]) {
+				minBound = bound{loc: subLocs[k], value: subVals[k]}
+				maxBound = bound{loc: subLocs[k+1], value: subVals[k+1]}
+				found = true
+				break
+			}
+		}
+		if !found {
+			if math.Abs(subVals[len(subVals)-1]) < tol {
+				return subLocs[len(subLocs)-1], nil
+			}
+			return minBoundValue(minBound, maxBound), ErrNoRoot
+		}
+	}
+	return minBoundValue(minBound, maxBound), ErrMaxEval
+}
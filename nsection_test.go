@@ -0,0 +1,48 @@
+// Copyright 2014 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file
+
+package root
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParallelNsection(t *testing.T) {
+	for _, test := range []struct {
+		Name string
+		Fun  func(x float64) float64
+		Min  float64
+		Max  float64
+		Tol  float64
+		Ans  float64
+	}{
+		{
+			Name: "Linear",
+			Fun:  func(x float64) float64 { return x - 7 },
+			Min:  -3,
+			Max:  10,
+			Tol:  1e-10,
+			Ans:  7,
+		},
+		{
+			Name: "Cubic",
+			Fun:  func(x float64) float64 { return x*x*x - x - 2 },
+			Min:  1,
+			Max:  2,
+			Tol:  1e-10,
+			Ans:  1.5213797068045676,
+		},
+	} {
+		settings := &Settings{Concurrent: true, MaxWorkers: 4}
+		ans, err := Find(test.Fun, test.Min, test.Max, test.Tol, settings)
+		if err != nil {
+			t.Errorf("Case %v: error in Find: %v", test.Name, err)
+			continue
+		}
+		if math.Abs(ans-test.Ans) > test.Tol {
+			t.Errorf("Case %v: tolerance not met. Want %v, Got %v", test.Name, test.Ans, ans)
+		}
+	}
+}
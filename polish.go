@@ -0,0 +1,107 @@
+// Copyright 2014 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file
+
+package root
+
+import "math"
+
+// PolishRoot refines a bracket [a,b] with f(a) and f(b) of opposite sign into
+// a root, alternating one Illinois-modified false-position step with one
+// bisection step. Plain false position can converge one-sidedly when f is
+// convex or concave near the root, stalling one endpoint for many
+// iterations; the Illinois modification cures this by halving the function
+// value of an endpoint that has been retained two falsi steps in a row,
+// pulling the next interpolation toward it. Interleaving a bisection step
+// guarantees the bracket still shrinks geometrically even when falsi
+// struggles, so PolishRoot never does worse than plain bisection while
+// usually converging superlinearly.
+//
+// PolishRoot returns as soon as |f(x)| < tol or the bracket width drops
+// below tol. It returns ErrNoRoot if f(a) and f(b) do not have opposite
+// sign, ErrNaN if f evaluates to NaN, and ErrMaxEval if maxIter rounds are
+// exhausted without convergence.
+func PolishRoot(f func(float64) float64, a, b, tol float64, maxIter int) (float64, error) {
+	fa := f(a)
+	fb := f(b)
+	if math.IsNaN(fa) || math.IsNaN(fb) {
+		return math.NaN(), ErrNaN
+	}
+	if sameSign(fa, fb) {
+		return a, ErrNoRoot
+	}
+
+	// lastSide records which endpoint the previous falsi step replaced, so
+	// the Illinois modification can detect two-in-a-row retentions of the
+	// other endpoint. 0 means no falsi step has run yet, or the bisection
+	// step since then replaced the endpoint that falsi step retained.
+	lastSide := 0
+
+	// ga and gb are the endpoint values used for interpolation; the Illinois
+	// modification halves them, while fa and fb keep the true values.
+	ga, gb := fa, fb
+
+	for i := 0; i < maxIter; i++ {
+		if math.Abs(fa) < tol {
+			return a, nil
+		}
+		if math.Abs(fb) < tol {
+			return b, nil
+		}
+		if math.Abs(b-a) < tol {
+			return (a + b) / 2, nil
+		}
+
+		// Illinois-modified false position step.
+		xFP := (a*gb - b*ga) / (gb - ga)
+		fFP := f(xFP)
+		if math.IsNaN(fFP) {
+			return xFP, ErrNaN
+		}
+		if math.Abs(fFP) < tol {
+			return xFP, nil
+		}
+		if sameSign(fa, fFP) {
+			if lastSide == 1 {
+				gb /= 2
+			}
+			a, fa, ga = xFP, fFP, fFP
+			lastSide = 1
+		} else {
+			if lastSide == -1 {
+				ga /= 2
+			}
+			b, fb, gb = xFP, fFP, fFP
+			lastSide = -1
+		}
+		if math.Abs(b-a) < tol {
+			return (a + b) / 2, nil
+		}
+
+		// Bisection step, to guarantee the bracket keeps shrinking
+		// geometrically even when falsi stalls.
+		mid := (a + b) / 2
+		fmid := f(mid)
+		if math.IsNaN(fmid) {
+			return mid, ErrNaN
+		}
+		if math.Abs(fmid) < tol {
+			return mid, nil
+		}
+		if sameSign(fa, fmid) {
+			a, fa, ga = mid, fmid, fmid
+			if lastSide == -1 {
+				lastSide = 0
+			}
+		} else {
+			b, fb, gb = mid, fmid, fmid
+			if lastSide == 1 {
+				lastSide = 0
+			}
+		}
+		if math.Abs(b-a) < tol {
+			return (a + b) / 2, nil
+		}
+	}
+	return (a + b) / 2, ErrMaxEval
+}
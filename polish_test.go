@@ -0,0 +1,59 @@
+// Copyright 2014 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file
+
+package root
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPolishRoot(t *testing.T) {
+	for _, test := range []struct {
+		Name string
+		Fun  func(x float64) float64
+		A    float64
+		B    float64
+		Tol  float64
+		Ans  float64
+	}{
+		{
+			Name: "Linear",
+			Fun:  func(x float64) float64 { return x - 7 },
+			A:    -3,
+			B:    10,
+			Tol:  1e-12,
+			Ans:  7,
+		},
+		{
+			// One-sided false position stalls on this convex function;
+			// PolishRoot's Illinois modification and bisection step should
+			// still converge within maxIter.
+			Name: "Convex",
+			Fun:  func(x float64) float64 { return x*x - 2 },
+			A:    0,
+			B:    2,
+			Tol:  1e-12,
+			Ans:  math.Sqrt2,
+		},
+	} {
+		ans, err := PolishRoot(test.Fun, test.A, test.B, test.Tol, noRootIter)
+		if err != nil {
+			t.Errorf("Case %v: error in PolishRoot: %v", test.Name, err)
+			continue
+		}
+		if math.Abs(ans-test.Ans) > test.Tol {
+			t.Errorf("Case %v: tolerance not met. Want %v, Got %v", test.Name, test.Ans, ans)
+		}
+
+		ansFind, err := Find(test.Fun, test.A, test.B, test.Tol, &Settings{Bisection: true, Polish: true})
+		if err != nil {
+			t.Errorf("Case %v: error in Find with Polish: %v", test.Name, err)
+			continue
+		}
+		if math.Abs(ansFind-test.Ans) > test.Tol {
+			t.Errorf("Case %v: tolerance not met with Find+Polish. Want %v, Got %v", test.Name, test.Ans, ansFind)
+		}
+	}
+}
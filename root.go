@@ -8,6 +8,8 @@ import (
 	"errors"
 	"math"
 	"sync"
+
+	"github.com/gonum/general"
 )
 
 var (
@@ -38,6 +40,14 @@ type Settings struct {
 	MaxEvals    int     // maximum number of function evaluations
 	Concurrent  bool    // Enable concurrency (when appropriate)
 	MaxWorkers  int     // Concurrency level?
+	Bisection   bool    // Use plain bisection instead of Brent's method; ignored if UseDerivative is set or Concurrent is set with MaxWorkers > 1
+	Polish      bool    // With Bisection, refine the bracket with PolishRoot instead of plain bisection, for superlinear final convergence
+
+	// UseDerivative runs a safeguarded Newton's method on f, using the
+	// derivative supplied by UseDerivative.Diff, falling back to a bisection
+	// step whenever a Newton step would leave the bracket or fail to
+	// sufficiently reduce |f|. If nil, no derivative is used.
+	UseDerivative general.FuncDiffer
 }
 
 // Find finds an x for which abs(f(x)) < tol. Min and max provide lower and upper
@@ -69,7 +79,6 @@ func Find(f func(float64) float64, min, max, tol float64, settings *Settings) (f
 	// Choose a root finding method:
 	// TODO: Here's how it should be:
 	// GoldenRule if serial and no bound
-	// Parallel bisection if concurrent
 	// Fibbonacci if MaxEvals is "small"
 
 	var maxEvals int
@@ -79,8 +88,32 @@ func Find(f func(float64) float64, min, max, tol float64, settings *Settings) (f
 		maxEvals = settings.MaxEvals
 	}
 
-	// For now, just do a bisection
-	return bisection(f, minBound, maxBound, tol, nFunEvals, maxEvals)
+	if settings != nil && settings.UseDerivative != nil {
+		return safeguardedNewton(f, settings.UseDerivative.Diff(), minBound, maxBound, tol, nFunEvals, maxEvals)
+	}
+
+	if settings != nil && settings.Concurrent && settings.MaxWorkers > 1 {
+		return parallelNsection(f, minBound, maxBound, tol, settings.MaxWorkers, nFunEvals, maxEvals)
+	}
+
+	if settings != nil && settings.Bisection {
+		if settings.Polish {
+			// PolishRoot evaluates f at both ends of the bracket, then twice
+			// per iteration.
+			maxIter := noRootIter
+			if maxEvals > 0 {
+				if n := (maxEvals - nFunEvals - 2) / 2; n < maxIter {
+					maxIter = n
+				}
+			}
+			return PolishRoot(f, minBound.loc, maxBound.loc, tol, maxIter)
+		}
+		return bisection(f, minBound, maxBound, tol, nFunEvals, maxEvals)
+	}
+
+	// Brent's method converges at least as fast as bisection, so use it by
+	// default. Settings.Bisection opts back into plain bisection.
+	return brent(f, minBound, maxBound, tol, nFunEvals, maxEvals)
 }
 
 // minBoundValue returns the location of the bound whose location is closer to zero
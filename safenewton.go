@@ -0,0 +1,73 @@
+// Copyright 2014 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file
+
+package root
+
+import "math"
+
+// newtonReduceFactor is the largest ratio of the new |f| to the current
+// estimate's |f| for which safeguardedNewton accepts a Newton step.
+const newtonReduceFactor = 0.99
+
+// unexported safeguardedNewton assumes error checking has all been done, and
+// that the values are correct at the bounds. nFunEvals is how many have
+// already been done, and maxFunEvals is how many can be done.
+//
+// safeguardedNewton mirrors the hybrid strategy Brent's zeroin uses for its
+// derivative-free case: a Newton step x - f(x)/f'(x) is taken whenever it
+// falls strictly inside the current bracket and reduces |f| to at most
+// newtonReduceFactor times its current value; otherwise a bisection step is
+// taken instead. This
+// gives quadratic convergence when the derivative is well-behaved while
+// retaining bisection's guaranteed convergence within the bracket.
+func safeguardedNewton(f, fp func(float64) float64, minBound, maxBound bound, tol float64, nFunEvals, maxFunEvals int) (float64, error) {
+	a, fa := minBound.loc, minBound.value
+	b, fb := maxBound.loc, maxBound.value
+
+	// x is the current best estimate of the root.
+	x, fx := b, fb
+	if math.Abs(fa) < math.Abs(fb) {
+		x, fx = a, fa
+	}
+
+	for i := 0; i < noRootIter; i++ {
+		if math.Abs(fx) < tol || math.Abs(b-a) < tol {
+			return x, nil
+		}
+		if maxFunEvals > 0 && nFunEvals > maxFunEvals {
+			return x, ErrMaxEval
+		}
+
+		deriv := fp(x)
+		xNew := x - fx/deriv
+		newtonOK := deriv != 0 && xNew > a && xNew < b
+
+		var fNew float64
+		if newtonOK {
+			fNew = f(xNew)
+			nFunEvals++
+			if math.IsNaN(fNew) {
+				return x, ErrNaN
+			}
+			newtonOK = math.Abs(fNew) <= newtonReduceFactor*math.Abs(fx)
+		}
+
+		if !newtonOK {
+			xNew = (a + b) / 2
+			fNew = f(xNew)
+			nFunEvals++
+			if math.IsNaN(fNew) {
+				return x, ErrNaN
+			}
+		}
+
+		if sameSign(fa, fNew) {
+			a, fa = xNew, fNew
+		} else {
+			b, fb = xNew, fNew
+		}
+		x, fx = xNew, fNew
+	}
+	return x, ErrMaxEval
+}
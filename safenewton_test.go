@@ -0,0 +1,72 @@
+// Copyright 2014 The Gonum Authors. All rights reserved.
+// Use of this code is governed by a BSD-style
+// license that can be found in the LICENSE file
+
+package root
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gonum/general"
+)
+
+type diffFunc struct {
+	f, fp func(float64) float64
+}
+
+func (d diffFunc) Function() func(float64) float64 { return d.f }
+func (d diffFunc) Diff() func(float64) float64     { return d.fp }
+
+func TestFindUseDerivative(t *testing.T) {
+	for _, test := range []struct {
+		Name string
+		Fun  func(x float64) float64
+		Diff func(x float64) float64
+		Min  float64
+		Max  float64
+		Tol  float64
+		Ans  float64
+	}{
+		{
+			Name: "Linear",
+			Fun:  func(x float64) float64 { return x - 7 },
+			Diff: func(x float64) float64 { return 1 },
+			Min:  -3,
+			Max:  10,
+			Tol:  1e-14,
+			Ans:  7,
+		},
+		{
+			Name: "Cubic",
+			Fun:  func(x float64) float64 { return x*x*x - x - 2 },
+			Diff: func(x float64) float64 { return 3*x*x - 1 },
+			Min:  1,
+			Max:  2,
+			Tol:  1e-12,
+			Ans:  1.5213797068045676,
+		},
+	} {
+		settings := &Settings{UseDerivative: diffFunc{f: test.Fun, fp: test.Diff}}
+		ans, err := Find(test.Fun, test.Min, test.Max, test.Tol, settings)
+		if err != nil {
+			t.Errorf("Case %v: error in Find: %v", test.Name, err)
+			continue
+		}
+		if math.Abs(ans-test.Ans) > test.Tol {
+			t.Errorf("Case %v: tolerance not met. Want %v, Got %v", test.Name, test.Ans, ans)
+		}
+	}
+}
+
+func TestNewton(t *testing.T) {
+	f := func(x float64) float64 { return x*x - 2 }
+	fp := func(x float64) float64 { return 2 * x }
+	n := NewNewton(diffFunc{f: f, fp: fp})
+	ans := n.Compute(1)
+	if math.Abs(ans-math.Sqrt2) > n.Tol*10 {
+		t.Errorf("tolerance not met. Want %v, Got %v", math.Sqrt2, ans)
+	}
+}
+
+var _ general.FuncDiffer = diffFunc{}